@@ -0,0 +1,94 @@
+package main
+
+// KillEvent describes a single kill as it is processed, for callback subscribers.
+type KillEvent struct {
+	GameID string
+	Killer string
+	Victim string
+	Weapon string
+}
+
+// ClientInfoEvent describes a ClientUserinfoChanged update, for callback subscribers.
+type ClientInfoEvent struct {
+	GameID   string
+	ClientID int
+	Name     string
+}
+
+// Callbacks holds the typed event hooks a consumer can register on a Parser to
+// observe parsing as it happens, instead of waiting for ParseFile to return.
+// This mirrors the subscription style used by streaming demo/replay parsers:
+// register handlers up front, then drive the parser and let it push events out.
+type Callbacks struct {
+	onInitGame              []func(gameID string)
+	onKill                  []func(ev KillEvent)
+	onClientUserinfoChanged []func(ev ClientInfoEvent)
+	onShutdownGame          []func(gameID string, summary *Game)
+	onEvent                 map[string][]func(entry *LogEntry)
+}
+
+// NewCallbacks creates an empty callback registry.
+func NewCallbacks() *Callbacks {
+	return &Callbacks{
+		onEvent: make(map[string][]func(entry *LogEntry)),
+	}
+}
+
+// OnInitGame registers a handler invoked whenever a new game starts.
+func (c *Callbacks) OnInitGame(fn func(gameID string)) {
+	c.onInitGame = append(c.onInitGame, fn)
+}
+
+// OnKill registers a handler invoked for every processed kill event.
+func (c *Callbacks) OnKill(fn func(ev KillEvent)) {
+	c.onKill = append(c.onKill, fn)
+}
+
+// OnClientUserinfoChanged registers a handler invoked whenever a client's
+// player info (most notably their name) is updated.
+func (c *Callbacks) OnClientUserinfoChanged(fn func(ev ClientInfoEvent)) {
+	c.onClientUserinfoChanged = append(c.onClientUserinfoChanged, fn)
+}
+
+// OnShutdownGame registers a handler invoked when a game is finalized, passing
+// the completed Game summary.
+func (c *Callbacks) OnShutdownGame(fn func(gameID string, summary *Game)) {
+	c.onShutdownGame = append(c.onShutdownGame, fn)
+}
+
+// OnEvent registers a generic handler for a raw log event name (e.g. "Item",
+// "ClientBegin"), for consumers who want events this parser doesn't model
+// with a dedicated callback yet.
+func (c *Callbacks) OnEvent(name string, fn func(entry *LogEntry)) {
+	c.onEvent[name] = append(c.onEvent[name], fn)
+}
+
+func (c *Callbacks) fireInitGame(gameID string) {
+	for _, fn := range c.onInitGame {
+		fn(gameID)
+	}
+}
+
+func (c *Callbacks) fireKill(ev KillEvent) {
+	for _, fn := range c.onKill {
+		fn(ev)
+	}
+}
+
+func (c *Callbacks) fireClientUserinfoChanged(ev ClientInfoEvent) {
+	for _, fn := range c.onClientUserinfoChanged {
+		fn(ev)
+	}
+}
+
+func (c *Callbacks) fireShutdownGame(gameID string, summary *Game) {
+	for _, fn := range c.onShutdownGame {
+		fn(gameID, summary)
+	}
+}
+
+func (c *Callbacks) fireEvent(entry *LogEntry) {
+	for _, fn := range c.onEvent[entry.Event] {
+		fn(entry)
+	}
+}
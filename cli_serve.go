@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os/signal"
+	"syscall"
+)
+
+// runServeCommand implements the "serve" subcommand: it starts the HTTP API
+// against a Store, optionally tailing a live games.log into that Store in
+// the background so the API stays current as a dedicated server runs.
+func runServeCommand(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	dbPath := fs.String("db", "", "path to a SQLite database file; defaults to an in-memory store")
+	ingestFile := fs.String("ingest", "", "games.log to tail and upsert into the store as it grows")
+	fs.Parse(args)
+
+	var store Store
+	if *dbPath != "" {
+		sqliteStore, err := NewSQLiteStore(*dbPath)
+		if err != nil {
+			log.Fatalf("Error opening store: %v", err)
+		}
+		defer sqliteStore.Close()
+		store = sqliteStore
+	} else {
+		store = NewMemoryStore()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if *ingestFile != "" {
+		go func() {
+			if err := IngestTail(ctx, *ingestFile, store); err != nil {
+				log.Printf("Warning: ingest stopped: %v", err)
+			}
+		}()
+		fmt.Printf("Ingesting from %s\n", *ingestFile)
+	}
+
+	fmt.Printf("Serving API on %s\n", *addr)
+	if err := RunServer(*addr, store); err != nil {
+		log.Fatalf("Error running server: %v", err)
+	}
+}
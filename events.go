@@ -0,0 +1,41 @@
+package main
+
+// EventType identifies which typed payload an Event carries.
+type EventType string
+
+// Event types emitted on the channel returned by ParseTail.
+const (
+	EventTypeInitGame   EventType = "init_game"
+	EventTypeKill       EventType = "kill"
+	EventTypePlayerJoin EventType = "player_join"
+	EventTypeExit       EventType = "exit"
+)
+
+// InitGameEvent is emitted when a new game starts.
+type InitGameEvent struct {
+	GameID string
+}
+
+// PlayerJoinEvent is emitted when a player's canonical name is first
+// established for a game (from a ClientUserinfoChanged line).
+type PlayerJoinEvent struct {
+	GameID   string
+	ClientID int
+	Name     string
+}
+
+// ExitEvent is emitted when a game ends, either via an Exit or
+// ShutdownGame log line.
+type ExitEvent struct {
+	GameID string
+}
+
+// Event is the envelope delivered on the channel returned by ParseTail. Only
+// the field matching Type is populated; the others are left zero.
+type Event struct {
+	Type       EventType
+	InitGame   *InitGameEvent
+	Kill       *KillEvent
+	PlayerJoin *PlayerJoinEvent
+	Exit       *ExitEvent
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"context"
+	"log"
+)
+
+// IngestTail runs ParseTail's follow-and-resume loop against filename,
+// upserting each game into store as soon as it's finalized (on Exit or
+// ShutdownGame). It blocks until ctx is canceled, so "serve --ingest" runs
+// it in its own goroutine alongside RunServer. Save failures are logged as
+// they happen rather than held for a return, since a long-running ingest
+// may never return: holding only the most recent error would also silently
+// drop every earlier failed game.
+func IngestTail(ctx context.Context, filename string, store Store) error {
+	parser := NewParser()
+
+	parser.Callbacks.OnShutdownGame(func(gameID string, summary *Game) {
+		if err := store.SaveGame(summary); err != nil {
+			log.Printf("error saving game %s: %v", gameID, err)
+		}
+	})
+
+	return TailInto(ctx, filename, parser, DefaultTailOptions().PollInterval)
+}
@@ -0,0 +1,23 @@
+package main
+
+import "fmt"
+
+// ExampleKillstreakCounter demonstrates building a live killstreak counter on
+// top of the callback API: subscribe to OnKill, track a running streak per
+// player, and reset a player's streak whenever they die. It's meant as a
+// reference for consumers wiring up their own streaming aggregations (e.g.
+// heatmaps, chat extraction, external metric export) rather than something
+// the CLI calls itself.
+func ExampleKillstreakCounter(p *Parser) {
+	streaks := make(map[string]int)
+
+	p.Callbacks.OnKill(func(ev KillEvent) {
+		if ev.Killer != "" && ev.Killer != "<world>" {
+			streaks[ev.Killer]++
+			if streaks[ev.Killer] > 1 && streaks[ev.Killer]%5 == 0 {
+				fmt.Printf("%s is on a %d-kill streak!\n", ev.Killer, streaks[ev.Killer])
+			}
+		}
+		streaks[ev.Victim] = 0
+	})
+}
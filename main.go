@@ -19,6 +19,20 @@ type Game struct {
 	Players      []string       `json:"players"`
 	Kills        map[string]int `json:"kills"`
 	KillsByMeans map[string]int `json:"kills_by_means,omitempty"`
+
+	// KillsByMODCount mirrors KillsByMeans but keyed by the decoded
+	// MeansOfDeath enum instead of the raw log token, for consumers of
+	// GetKillsByMeansReport/GetPlayerWeaponStats.
+	KillsByMODCount map[MeansOfDeath]int `json:"-"`
+
+	// WeaponKills and WeaponDeaths track, per player, how many kills they
+	// scored and deaths they suffered with each means of death.
+	WeaponKills  map[string]map[MeansOfDeath]int `json:"-"`
+	WeaponDeaths map[string]map[MeansOfDeath]int `json:"-"`
+
+	// Timeline is the chronologically ordered sequence of notable events
+	// (joins, renames, kills, exit/shutdown) for this game.
+	Timeline []TimelineEvent `json:"timeline,omitempty"`
 }
 
 // PlayerManager handles consistent player name mapping and normalization
@@ -30,10 +44,12 @@ type PlayerManager struct {
 
 // Parser handles the log parsing logic and maintains game state
 type Parser struct {
-	Games       map[string]*Game // All parsed games indexed by game ID
-	CurrentGame *Game            // Currently active game being parsed
-	PlayerMgr   *PlayerManager   // Enhanced player name management
-	GameCounter int              // Counter for generating game IDs
+	Games            map[string]*Game // All parsed games indexed by game ID
+	CurrentGame      *Game            // Currently active game being parsed
+	PlayerMgr        *PlayerManager   // Enhanced player name management
+	GameCounter      int              // Counter for generating game IDs
+	Callbacks        *Callbacks       // Registered hooks fired as entries are processed
+	gameStartSeconds int              // InitGame timestamp (seconds) of CurrentGame, for timeline elapsed times
 }
 
 // LogEntry represents a parsed log line with timestamp and event data
@@ -92,8 +108,12 @@ func (pm *PlayerManager) normalizePlayerName(name string) string {
 	return normalized
 }
 
-// registerPlayer registers a player with their client ID and establishes canonical name
-func (pm *PlayerManager) registerPlayer(clientID int, rawName string) string {
+// registerPlayer registers a player with their client ID and establishes
+// canonical name. It also reports what kind of registration this was
+// ("join", "rename", or "unchanged") and, for a rename, the canonical name
+// being replaced, so callers like the timeline builder can tell a new
+// player from one who just changed names and say what they changed from.
+func (pm *PlayerManager) registerPlayer(clientID int, rawName string) (canonicalName, registration, previousName string) {
 	canonical := pm.normalizePlayerName(rawName)
 
 	// If this client ID already has a canonical name, check if it's consistent
@@ -102,12 +122,19 @@ func (pm *PlayerManager) registerPlayer(clientID int, rawName string) string {
 		if canonical == existingCanonical {
 			// Still register the raw name variant for lookup
 			pm.nameVariations[rawName] = existingCanonical
-			return existingCanonical
+			return existingCanonical, "unchanged", ""
 		}
 		// If they're different, the player might have changed their name
 		// We'll use the most recent one but log this for debugging
 		fmt.Printf("Debug: Player with client ID %d changed name from '%s' to '%s'\n",
 			clientID, existingCanonical, canonical)
+		pm.clientToName[clientID] = canonical
+		pm.canonicalNames[canonical] = true
+		pm.nameVariations[rawName] = canonical
+		if canonical != rawName {
+			pm.nameVariations[canonical] = canonical
+		}
+		return canonical, "rename", existingCanonical
 	}
 
 	// Register the canonical name
@@ -120,7 +147,7 @@ func (pm *PlayerManager) registerPlayer(clientID int, rawName string) string {
 		pm.nameVariations[canonical] = canonical
 	}
 
-	return canonical
+	return canonical, "join", ""
 }
 
 // getPlayerName returns the canonical name for a client ID or raw name
@@ -155,6 +182,7 @@ func NewParser() *Parser {
 		Games:       make(map[string]*Game),
 		PlayerMgr:   NewPlayerManager(),
 		GameCounter: 0,
+		Callbacks:   NewCallbacks(),
 	}
 }
 
@@ -216,29 +244,38 @@ func (p *Parser) parseLine(line string) (*LogEntry, error) {
 
 // processEntry handles different types of log events and updates game state accordingly
 func (p *Parser) processEntry(entry *LogEntry) error {
+	var err error
+
 	switch entry.Event {
 	case "InitGame":
 		// Start a new game session
-		return p.handleInitGame(entry)
+		err = p.handleInitGame(entry)
 	case "Exit":
 		// End current game session
-		return p.handleExit(entry)
+		err = p.handleExit(entry)
 	case "ClientConnect":
 		// Player connects to server (we track this but main info comes from ClientUserinfoChanged)
-		return p.handleClientConnect(entry)
+		err = p.handleClientConnect(entry)
 	case "ClientUserinfoChanged":
 		// Extract player name from client info - this is where we get actual player names
-		return p.handleClientUserinfoChanged(entry)
+		err = p.handleClientUserinfoChanged(entry)
 	case "Kill":
 		// Process kill events - the core logic for tracking kills
-		return p.handleKill(entry)
+		err = p.handleKill(entry)
 	case "ShutdownGame":
 		// Game shutdown event
-		return p.handleShutdown(entry)
+		err = p.handleShutdown(entry)
 	default:
 		// Ignore other event types (ClientBegin, item pickups, etc.)
-		return nil
 	}
+
+	// Fire the generic per-event-name callback regardless of outcome so
+	// subscribers can observe raw entries the parser doesn't model explicitly.
+	if p.Callbacks != nil {
+		p.Callbacks.fireEvent(entry)
+	}
+
+	return err
 }
 
 // handleInitGame starts a new game session
@@ -253,23 +290,54 @@ func (p *Parser) handleInitGame(entry *LogEntry) error {
 	gameID := fmt.Sprintf("game_%d", p.GameCounter)
 
 	p.CurrentGame = &Game{
-		ID:           gameID,
-		TotalKills:   0,
-		Players:      []string{},
-		Kills:        make(map[string]int),
-		KillsByMeans: make(map[string]int),
+		ID:              gameID,
+		TotalKills:      0,
+		Players:         []string{},
+		Kills:           make(map[string]int),
+		KillsByMeans:    make(map[string]int),
+		KillsByMODCount: make(map[MeansOfDeath]int),
+		WeaponKills:     make(map[string]map[MeansOfDeath]int),
+		WeaponDeaths:    make(map[string]map[MeansOfDeath]int),
 	}
 
 	// Clear client-player mapping for new game (players might rejoin with different IDs)
 	// Reset the player manager for the new game session
 	p.PlayerMgr = NewPlayerManager()
 
+	if seconds, err := parseTimestampSeconds(entry.Timestamp); err == nil {
+		p.gameStartSeconds = seconds
+	} else {
+		p.gameStartSeconds = 0
+	}
+
+	if p.Callbacks != nil {
+		p.Callbacks.fireInitGame(gameID)
+	}
+
 	return nil
 }
 
+// resumeGame installs id as CurrentGame with freshly initialized stats,
+// without touching GameCounter or PlayerMgr. It's used when a tail session
+// resumes mid-game after a restart: the game already has an ID (persisted
+// alongside the read offset), it just never saw this process's InitGame.
+func (p *Parser) resumeGame(id string) {
+	p.CurrentGame = &Game{
+		ID:              id,
+		Players:         []string{},
+		Kills:           make(map[string]int),
+		KillsByMeans:    make(map[string]int),
+		KillsByMODCount: make(map[MeansOfDeath]int),
+		WeaponKills:     make(map[string]map[MeansOfDeath]int),
+		WeaponDeaths:    make(map[string]map[MeansOfDeath]int),
+	}
+	p.gameStartSeconds = 0
+}
+
 // handleExit finalizes the current game when it ends
 func (p *Parser) handleExit(entry *LogEntry) error {
 	if p.CurrentGame != nil {
+		p.recordTimelineEvent(entry, TimelineEvent{Type: TimelineExit})
 		p.finalizeGame()
 		p.CurrentGame = nil
 	}
@@ -299,11 +367,24 @@ func (p *Parser) handleClientUserinfoChanged(entry *LogEntry) error {
 	rawPlayerName := matches[2]
 
 	// Register player with the player manager to get canonical name
-	canonicalName := p.PlayerMgr.registerPlayer(clientID, rawPlayerName)
+	canonicalName, registration, previousName := p.PlayerMgr.registerPlayer(clientID, rawPlayerName)
 
 	// Add player to current game if not already present and game exists
 	if p.CurrentGame != nil {
 		p.addPlayerToGame(canonicalName)
+		p.recordTimelineRegistration(entry, registration, canonicalName, previousName)
+	}
+
+	if p.Callbacks != nil {
+		gameID := ""
+		if p.CurrentGame != nil {
+			gameID = p.CurrentGame.ID
+		}
+		p.Callbacks.fireClientUserinfoChanged(ClientInfoEvent{
+			GameID:   gameID,
+			ClientID: clientID,
+			Name:     canonicalName,
+		})
 	}
 
 	return nil
@@ -337,12 +418,15 @@ func (p *Parser) handleKill(entry *LogEntry) error {
 	killerNameFromEvent := strings.TrimSpace(matches[4])
 	victimNameFromEvent := strings.TrimSpace(matches[5])
 	weaponName := strings.TrimSpace(matches[6])
+	mod := ParseMeansOfDeath(weaponName)
 
 	// Get canonical player names using enhanced player management
 	// This will use client ID mapping when available, or normalize the name as fallback
 	var killerName, victimName string
 
-	if killerNameFromEvent == "<world>" {
+	if killerNameFromEvent == "<world>" || IsEnvironmental(mod) {
+		// Environmental means of death (falling, lava, a hurt trigger, ...)
+		// are the world's doing no matter what client ID the log line lists.
 		killerName = "<world>"
 	} else {
 		killerName = p.PlayerMgr.getPlayerName(killerID, killerNameFromEvent)
@@ -362,7 +446,9 @@ func (p *Parser) handleKill(entry *LogEntry) error {
 	p.CurrentGame.TotalKills++
 
 	// Track weapon/means of death statistics
-	p.CurrentGame.KillsByMeans[weaponName]++
+	p.CurrentGame.KillsByMeans[mod.String()]++
+	p.CurrentGame.KillsByMODCount[mod]++
+	p.trackWeaponDeath(victimName, mod)
 
 	// Add both killer and victim to players list (victim always gets added)
 	p.addPlayerToGame(victimName)
@@ -378,15 +464,51 @@ func (p *Parser) handleKill(entry *LogEntry) error {
 		// Regular player kill: add killer to game and increment their kill count
 		p.addPlayerToGame(killerName)
 		p.CurrentGame.Kills[killerName]++
+		p.trackWeaponKill(killerName, mod)
+	}
+
+	p.recordTimelineEvent(entry, TimelineEvent{
+		Type:   TimelineKill,
+		Killer: killerName,
+		Victim: victimName,
+		Weapon: mod.String(),
+	})
+
+	if p.Callbacks != nil {
+		p.Callbacks.fireKill(KillEvent{
+			GameID: p.CurrentGame.ID,
+			Killer: killerName,
+			Victim: victimName,
+			Weapon: mod.String(),
+		})
 	}
 
 	return nil
 }
 
+// trackWeaponKill records a kill scored by player with the given means of
+// death, for GetPlayerWeaponStats.
+func (p *Parser) trackWeaponKill(player string, mod MeansOfDeath) {
+	if p.CurrentGame.WeaponKills[player] == nil {
+		p.CurrentGame.WeaponKills[player] = make(map[MeansOfDeath]int)
+	}
+	p.CurrentGame.WeaponKills[player][mod]++
+}
+
+// trackWeaponDeath records a death suffered by player to the given means of
+// death, for GetPlayerWeaponStats.
+func (p *Parser) trackWeaponDeath(player string, mod MeansOfDeath) {
+	if p.CurrentGame.WeaponDeaths[player] == nil {
+		p.CurrentGame.WeaponDeaths[player] = make(map[MeansOfDeath]int)
+	}
+	p.CurrentGame.WeaponDeaths[player][mod]++
+}
+
 // handleShutdown processes game shutdown events
 func (p *Parser) handleShutdown(entry *LogEntry) error {
 	// Similar to Exit - finalize current game
 	if p.CurrentGame != nil {
+		p.recordTimelineEvent(entry, TimelineEvent{Type: TimelineShutdown})
 		p.finalizeGame()
 		p.CurrentGame = nil
 	}
@@ -426,6 +548,10 @@ func (p *Parser) finalizeGame() {
 
 	// Store the completed game
 	p.Games[p.CurrentGame.ID] = p.CurrentGame
+
+	if p.Callbacks != nil {
+		p.Callbacks.fireShutdownGame(p.CurrentGame.ID, p.CurrentGame)
+	}
 }
 
 // GetSingleGameOutput returns the basic required output format for single game analysis
@@ -503,10 +629,16 @@ func main() {
 	// Check command line arguments
 	if len(os.Args) < 2 {
 		fmt.Println("Usage: go run main.go <log_file> [output_format]")
-		fmt.Println("Output formats: basic (default), multi, ranking")
+		fmt.Println("       go run main.go serve [-addr :8080] [-db games.db] [-ingest games.log]")
+		fmt.Println("Output formats: basic (default), multi, ranking, deaths, timeline, replay")
 		os.Exit(1)
 	}
 
+	if os.Args[1] == "serve" {
+		runServeCommand(os.Args[2:])
+		return
+	}
+
 	logFile := os.Args[1]
 	outputFormat := "basic"
 	if len(os.Args) > 2 {
@@ -549,6 +681,40 @@ func main() {
 		// Player rankings output
 		parser.PrintRankings()
 
+	case "deaths":
+		// Per-game kills_by_means breakdown (the Cloudwalk log parsing
+		// challenge's "kills_by_means" spec)
+		report := parser.GetKillsByMeansReport()
+		jsonOutput, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			log.Fatalf("Error marshaling JSON: %v", err)
+		}
+		fmt.Println("\nKills By Means:")
+		fmt.Println(string(jsonOutput))
+
+	case "timeline":
+		// Per-game timeline as structured JSON, for consumers that want to
+		// walk the raw events themselves instead of "replay"'s rendered text.
+		timelines := make(map[string][]TimelineEvent, len(parser.Games))
+		for gameID, game := range parser.Games {
+			timelines[gameID] = game.Timeline
+		}
+		jsonOutput, err := json.MarshalIndent(timelines, "", "  ")
+		if err != nil {
+			log.Fatalf("Error marshaling JSON: %v", err)
+		}
+		fmt.Println("\nTimeline Output:")
+		fmt.Println(string(jsonOutput))
+
+	case "replay":
+		// Human-readable minute-by-minute recap of each game
+		for _, gameID := range sortedGameIDs(parser.Games) {
+			fmt.Printf("\n=== %s ===\n", gameID)
+			if err := parser.Games[gameID].Replay(os.Stdout); err != nil {
+				log.Fatalf("Error rendering replay: %v", err)
+			}
+		}
+
 	case "all":
 		// Output all formats
 		fmt.Println("\n=== BASIC OUTPUT ===")
@@ -566,7 +732,7 @@ func main() {
 
 	default:
 		fmt.Printf("Unknown output format: %s\n", outputFormat)
-		fmt.Println("Available formats: basic, multi, ranking, all")
+		fmt.Println("Available formats: basic, multi, ranking, deaths, timeline, replay, all")
 		os.Exit(1)
 	}
 }
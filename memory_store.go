@@ -0,0 +1,110 @@
+package main
+
+import (
+	"sort"
+	"sync"
+)
+
+// MemoryStore is an in-memory Store implementation. It's the default
+// backend for "serve" and "ingest" when no database is configured, and is
+// handy for tests and short-lived demos where surviving a restart doesn't
+// matter.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	games map[string]*Game
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		games: make(map[string]*Game),
+	}
+}
+
+// SaveGame implements Store.
+func (s *MemoryStore) SaveGame(game *Game) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.games[game.ID] = game
+	return nil
+}
+
+// GetGame implements Store.
+func (s *MemoryStore) GetGame(id string) (*Game, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	game, ok := s.games[id]
+	if !ok {
+		return nil, ErrGameNotFound
+	}
+	return game, nil
+}
+
+// ListGames implements Store.
+func (s *MemoryStore) ListGames() ([]*Game, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	games := make([]*Game, 0, len(s.games))
+	for _, game := range s.games {
+		games = append(games, game)
+	}
+	sort.Slice(games, func(i, j int) bool { return games[i].ID < games[j].ID })
+	return games, nil
+}
+
+// PlayerMatches implements Store.
+func (s *MemoryStore) PlayerMatches(name string) ([]*Game, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matches []*Game
+	for _, game := range s.games {
+		if _, played := game.Kills[name]; played {
+			matches = append(matches, game)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches, nil
+}
+
+// Rankings implements Store.
+func (s *MemoryStore) Rankings() ([]PlayerRanking, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	playerTotals := make(map[string]int)
+	for _, game := range s.games {
+		for player, kills := range game.Kills {
+			playerTotals[player] += kills
+		}
+	}
+
+	rankings := make([]PlayerRanking, 0, len(playerTotals))
+	for player, kills := range playerTotals {
+		rankings = append(rankings, PlayerRanking{Name: player, Kills: kills})
+	}
+	sort.Slice(rankings, func(i, j int) bool {
+		if rankings[i].Kills == rankings[j].Kills {
+			return rankings[i].Name < rankings[j].Name
+		}
+		return rankings[i].Kills > rankings[j].Kills
+	})
+	return rankings, nil
+}
+
+// WeaponStats implements Store.
+func (s *MemoryStore) WeaponStats() (map[string]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	totals := make(map[string]int)
+	for _, game := range s.games {
+		for weapon, count := range game.KillsByMeans {
+			totals[weapon] += count
+		}
+	}
+	return totals, nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// NewServeMux builds the HTTP API's routes against store: GET /games,
+// GET /games/{id}, GET /players/{name}/matches, GET /rankings, and
+// GET /weapons. It's exposed separately from RunServer so callers (and
+// tests) can mount it on their own *http.Server or wrap it with middleware.
+func NewServeMux(store Store) *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/games", func(w http.ResponseWriter, r *http.Request) {
+		games, err := store.ListGames()
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, games)
+	})
+
+	mux.HandleFunc("/games/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/games/")
+		if id == "" {
+			writeJSONError(w, http.StatusNotFound, ErrGameNotFound)
+			return
+		}
+
+		game, err := store.GetGame(id)
+		if err == ErrGameNotFound {
+			writeJSONError(w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, game)
+	})
+
+	mux.HandleFunc("/players/", func(w http.ResponseWriter, r *http.Request) {
+		rest := strings.TrimPrefix(r.URL.Path, "/players/")
+		name, suffix, ok := strings.Cut(rest, "/matches")
+		if !ok || suffix != "" || name == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		matches, err := store.PlayerMatches(name)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, matches)
+	})
+
+	mux.HandleFunc("/rankings", func(w http.ResponseWriter, r *http.Request) {
+		rankings, err := store.Rankings()
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, rankings)
+	})
+
+	mux.HandleFunc("/weapons", func(w http.ResponseWriter, r *http.Request) {
+		stats, err := store.WeaponStats()
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, stats)
+	})
+
+	return mux
+}
+
+// RunServer starts the HTTP API on addr, serving parsed data out of store
+// until the process is killed.
+func RunServer(addr string, store Store) error {
+	return http.ListenAndServe(addr, NewServeMux(store))
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,458 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// schemaSQL creates the tables SQLiteStore relies on, each keyed by the
+// game's generated ID. Indexes on player name and game ID back the
+// /players/{name}/matches and /games/{id} endpoints respectively.
+const schemaSQL = `
+CREATE TABLE IF NOT EXISTS games (
+	id          TEXT PRIMARY KEY,
+	total_kills INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS players (
+	game_id TEXT NOT NULL,
+	name    TEXT NOT NULL,
+	PRIMARY KEY (game_id, name)
+);
+CREATE INDEX IF NOT EXISTS idx_players_name ON players (name);
+CREATE INDEX IF NOT EXISTS idx_players_game_id ON players (game_id);
+
+CREATE TABLE IF NOT EXISTS kills (
+	game_id TEXT NOT NULL,
+	player  TEXT NOT NULL,
+	kills   INTEGER NOT NULL,
+	PRIMARY KEY (game_id, player)
+);
+CREATE INDEX IF NOT EXISTS idx_kills_game_id ON kills (game_id);
+
+CREATE TABLE IF NOT EXISTS weapons (
+	game_id TEXT NOT NULL,
+	weapon  TEXT NOT NULL,
+	kills   INTEGER NOT NULL,
+	PRIMARY KEY (game_id, weapon)
+);
+CREATE INDEX IF NOT EXISTS idx_weapons_game_id ON weapons (game_id);
+
+CREATE TABLE IF NOT EXISTS kills_by_mod (
+	game_id TEXT NOT NULL,
+	mod     TEXT NOT NULL,
+	kills   INTEGER NOT NULL,
+	PRIMARY KEY (game_id, mod)
+);
+CREATE INDEX IF NOT EXISTS idx_kills_by_mod_game_id ON kills_by_mod (game_id);
+
+CREATE TABLE IF NOT EXISTS player_weapon_stats (
+	game_id TEXT NOT NULL,
+	player  TEXT NOT NULL,
+	mod     TEXT NOT NULL,
+	kills   INTEGER NOT NULL,
+	deaths  INTEGER NOT NULL,
+	PRIMARY KEY (game_id, player, mod)
+);
+CREATE INDEX IF NOT EXISTS idx_player_weapon_stats_game_id ON player_weapon_stats (game_id);
+
+CREATE TABLE IF NOT EXISTS timeline_events (
+	game_id         TEXT NOT NULL,
+	seq             INTEGER NOT NULL,
+	type            TEXT NOT NULL,
+	elapsed_seconds INTEGER NOT NULL,
+	player          TEXT NOT NULL DEFAULT '',
+	old_name        TEXT NOT NULL DEFAULT '',
+	killer          TEXT NOT NULL DEFAULT '',
+	victim          TEXT NOT NULL DEFAULT '',
+	weapon          TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (game_id, seq)
+);
+CREATE INDEX IF NOT EXISTS idx_timeline_events_game_id ON timeline_events (game_id);
+`
+
+// SQLiteStore is the default persistent Store backend: a single SQLite file
+// holding games, their rosters, per-player kill counts, and per-weapon kill
+// counts. The same schema and queries work unchanged against Postgres by
+// swapping the driver/DSN passed to sql.Open, for deployments that want a
+// shared server-side database instead of a local file.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// playerWeaponStat accumulates one player_weapon_stats row while SaveGame
+// merges Game.WeaponKills and Game.WeaponDeaths, which are keyed separately,
+// into the single kills+deaths row the schema stores per (player, mod).
+type playerWeaponStat struct {
+	kills  int
+	deaths int
+}
+
+// NewSQLiteStore opens (creating if necessary) a SQLite database at path
+// and ensures its schema exists.
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening sqlite database: %w", err)
+	}
+
+	if _, err := db.Exec(schemaSQL); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error creating schema: %w", err)
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+// Close releases the underlying database handle.
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+// SaveGame implements Store, replacing any previously stored rows for the
+// same game ID inside a single transaction.
+func (s *SQLiteStore) SaveGame(game *Game) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM games WHERE id = ?`, game.ID); err != nil {
+		return fmt.Errorf("error clearing game row: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM players WHERE game_id = ?`, game.ID); err != nil {
+		return fmt.Errorf("error clearing player rows: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM kills WHERE game_id = ?`, game.ID); err != nil {
+		return fmt.Errorf("error clearing kill rows: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM weapons WHERE game_id = ?`, game.ID); err != nil {
+		return fmt.Errorf("error clearing weapon rows: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM kills_by_mod WHERE game_id = ?`, game.ID); err != nil {
+		return fmt.Errorf("error clearing kills-by-mod rows: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM player_weapon_stats WHERE game_id = ?`, game.ID); err != nil {
+		return fmt.Errorf("error clearing player weapon stat rows: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM timeline_events WHERE game_id = ?`, game.ID); err != nil {
+		return fmt.Errorf("error clearing timeline event rows: %w", err)
+	}
+
+	if _, err := tx.Exec(`INSERT INTO games (id, total_kills) VALUES (?, ?)`, game.ID, game.TotalKills); err != nil {
+		return fmt.Errorf("error inserting game row: %w", err)
+	}
+
+	for _, player := range game.Players {
+		if _, err := tx.Exec(`INSERT INTO players (game_id, name) VALUES (?, ?)`, game.ID, player); err != nil {
+			return fmt.Errorf("error inserting player row: %w", err)
+		}
+	}
+
+	for player, kills := range game.Kills {
+		if _, err := tx.Exec(`INSERT INTO kills (game_id, player, kills) VALUES (?, ?, ?)`, game.ID, player, kills); err != nil {
+			return fmt.Errorf("error inserting kill row: %w", err)
+		}
+	}
+
+	for weapon, kills := range game.KillsByMeans {
+		if _, err := tx.Exec(`INSERT INTO weapons (game_id, weapon, kills) VALUES (?, ?, ?)`, game.ID, weapon, kills); err != nil {
+			return fmt.Errorf("error inserting weapon row: %w", err)
+		}
+	}
+
+	for mod, kills := range game.KillsByMODCount {
+		if _, err := tx.Exec(`INSERT INTO kills_by_mod (game_id, mod, kills) VALUES (?, ?, ?)`, game.ID, mod.String(), kills); err != nil {
+			return fmt.Errorf("error inserting kills-by-mod row: %w", err)
+		}
+	}
+
+	playerStats := make(map[[2]string]*playerWeaponStat)
+	statFor := func(player string, mod MeansOfDeath) *playerWeaponStat {
+		key := [2]string{player, mod.String()}
+		stat := playerStats[key]
+		if stat == nil {
+			stat = &playerWeaponStat{}
+			playerStats[key] = stat
+		}
+		return stat
+	}
+	for player, byMOD := range game.WeaponKills {
+		for mod, kills := range byMOD {
+			statFor(player, mod).kills = kills
+		}
+	}
+	for player, byMOD := range game.WeaponDeaths {
+		for mod, deaths := range byMOD {
+			statFor(player, mod).deaths = deaths
+		}
+	}
+	for key, stat := range playerStats {
+		player, mod := key[0], key[1]
+		if _, err := tx.Exec(`INSERT INTO player_weapon_stats (game_id, player, mod, kills, deaths) VALUES (?, ?, ?, ?, ?)`,
+			game.ID, player, mod, stat.kills, stat.deaths); err != nil {
+			return fmt.Errorf("error inserting player weapon stat row: %w", err)
+		}
+	}
+
+	for seq, ev := range game.Timeline {
+		if _, err := tx.Exec(`INSERT INTO timeline_events (game_id, seq, type, elapsed_seconds, player, old_name, killer, victim, weapon) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			game.ID, seq, string(ev.Type), ev.Elapsed, ev.Player, ev.OldName, ev.Killer, ev.Victim, ev.Weapon); err != nil {
+			return fmt.Errorf("error inserting timeline event row: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetGame implements Store.
+func (s *SQLiteStore) GetGame(id string) (*Game, error) {
+	var game Game
+	game.ID = id
+
+	err := s.db.QueryRow(`SELECT total_kills FROM games WHERE id = ?`, id).Scan(&game.TotalKills)
+	if err == sql.ErrNoRows {
+		return nil, ErrGameNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error loading game: %w", err)
+	}
+
+	if err := s.loadGameDetails(&game); err != nil {
+		return nil, err
+	}
+	return &game, nil
+}
+
+// ListGames implements Store.
+func (s *SQLiteStore) ListGames() ([]*Game, error) {
+	rows, err := s.db.Query(`SELECT id, total_kills FROM games ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("error listing games: %w", err)
+	}
+	defer rows.Close()
+
+	var games []*Game
+	for rows.Next() {
+		game := &Game{}
+		if err := rows.Scan(&game.ID, &game.TotalKills); err != nil {
+			return nil, fmt.Errorf("error scanning game row: %w", err)
+		}
+		games = append(games, game)
+	}
+
+	for _, game := range games {
+		if err := s.loadGameDetails(game); err != nil {
+			return nil, err
+		}
+	}
+	return games, rows.Err()
+}
+
+// PlayerMatches implements Store.
+func (s *SQLiteStore) PlayerMatches(name string) ([]*Game, error) {
+	rows, err := s.db.Query(`SELECT DISTINCT game_id FROM players WHERE name = ? ORDER BY game_id`, name)
+	if err != nil {
+		return nil, fmt.Errorf("error querying player matches: %w", err)
+	}
+	defer rows.Close()
+
+	var gameIDs []string
+	for rows.Next() {
+		var gameID string
+		if err := rows.Scan(&gameID); err != nil {
+			return nil, fmt.Errorf("error scanning match row: %w", err)
+		}
+		gameIDs = append(gameIDs, gameID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	matches := make([]*Game, 0, len(gameIDs))
+	for _, gameID := range gameIDs {
+		game, err := s.GetGame(gameID)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, game)
+	}
+	return matches, nil
+}
+
+// Rankings implements Store.
+func (s *SQLiteStore) Rankings() ([]PlayerRanking, error) {
+	rows, err := s.db.Query(`
+		SELECT player, SUM(kills) AS total
+		FROM kills
+		GROUP BY player
+		ORDER BY total DESC, player ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("error computing rankings: %w", err)
+	}
+	defer rows.Close()
+
+	var rankings []PlayerRanking
+	for rows.Next() {
+		var ranking PlayerRanking
+		if err := rows.Scan(&ranking.Name, &ranking.Kills); err != nil {
+			return nil, fmt.Errorf("error scanning ranking row: %w", err)
+		}
+		rankings = append(rankings, ranking)
+	}
+	return rankings, rows.Err()
+}
+
+// WeaponStats implements Store.
+func (s *SQLiteStore) WeaponStats() (map[string]int, error) {
+	rows, err := s.db.Query(`SELECT weapon, SUM(kills) AS total FROM weapons GROUP BY weapon`)
+	if err != nil {
+		return nil, fmt.Errorf("error computing weapon stats: %w", err)
+	}
+	defer rows.Close()
+
+	totals := make(map[string]int)
+	for rows.Next() {
+		var weapon string
+		var total int
+		if err := rows.Scan(&weapon, &total); err != nil {
+			return nil, fmt.Errorf("error scanning weapon row: %w", err)
+		}
+		totals[weapon] = total
+	}
+	return totals, rows.Err()
+}
+
+// loadGameDetails fills in Players, Kills, KillsByMeans, KillsByMODCount,
+// WeaponKills, WeaponDeaths, and Timeline for a Game whose ID and
+// TotalKills have already been loaded.
+func (s *SQLiteStore) loadGameDetails(game *Game) error {
+	playerRows, err := s.db.Query(`SELECT name FROM players WHERE game_id = ? ORDER BY name`, game.ID)
+	if err != nil {
+		return fmt.Errorf("error loading players: %w", err)
+	}
+	defer playerRows.Close()
+
+	game.Players = []string{}
+	for playerRows.Next() {
+		var name string
+		if err := playerRows.Scan(&name); err != nil {
+			return fmt.Errorf("error scanning player row: %w", err)
+		}
+		game.Players = append(game.Players, name)
+	}
+	if err := playerRows.Err(); err != nil {
+		return err
+	}
+
+	killRows, err := s.db.Query(`SELECT player, kills FROM kills WHERE game_id = ?`, game.ID)
+	if err != nil {
+		return fmt.Errorf("error loading kills: %w", err)
+	}
+	defer killRows.Close()
+
+	game.Kills = make(map[string]int)
+	for killRows.Next() {
+		var player string
+		var kills int
+		if err := killRows.Scan(&player, &kills); err != nil {
+			return fmt.Errorf("error scanning kill row: %w", err)
+		}
+		game.Kills[player] = kills
+	}
+	if err := killRows.Err(); err != nil {
+		return err
+	}
+
+	weaponRows, err := s.db.Query(`SELECT weapon, kills FROM weapons WHERE game_id = ?`, game.ID)
+	if err != nil {
+		return fmt.Errorf("error loading weapons: %w", err)
+	}
+	defer weaponRows.Close()
+
+	game.KillsByMeans = make(map[string]int)
+	for weaponRows.Next() {
+		var weapon string
+		var kills int
+		if err := weaponRows.Scan(&weapon, &kills); err != nil {
+			return fmt.Errorf("error scanning weapon row: %w", err)
+		}
+		game.KillsByMeans[weapon] = kills
+	}
+	if err := weaponRows.Err(); err != nil {
+		return err
+	}
+
+	modRows, err := s.db.Query(`SELECT mod, kills FROM kills_by_mod WHERE game_id = ?`, game.ID)
+	if err != nil {
+		return fmt.Errorf("error loading kills-by-mod: %w", err)
+	}
+	defer modRows.Close()
+
+	game.KillsByMODCount = make(map[MeansOfDeath]int)
+	for modRows.Next() {
+		var mod string
+		var kills int
+		if err := modRows.Scan(&mod, &kills); err != nil {
+			return fmt.Errorf("error scanning kills-by-mod row: %w", err)
+		}
+		game.KillsByMODCount[ParseMeansOfDeath(mod)] = kills
+	}
+	if err := modRows.Err(); err != nil {
+		return err
+	}
+
+	statRows, err := s.db.Query(`SELECT player, mod, kills, deaths FROM player_weapon_stats WHERE game_id = ?`, game.ID)
+	if err != nil {
+		return fmt.Errorf("error loading player weapon stats: %w", err)
+	}
+	defer statRows.Close()
+
+	game.WeaponKills = make(map[string]map[MeansOfDeath]int)
+	game.WeaponDeaths = make(map[string]map[MeansOfDeath]int)
+	for statRows.Next() {
+		var player, mod string
+		var kills, deaths int
+		if err := statRows.Scan(&player, &mod, &kills, &deaths); err != nil {
+			return fmt.Errorf("error scanning player weapon stat row: %w", err)
+		}
+		meansOfDeath := ParseMeansOfDeath(mod)
+		if kills > 0 {
+			if game.WeaponKills[player] == nil {
+				game.WeaponKills[player] = make(map[MeansOfDeath]int)
+			}
+			game.WeaponKills[player][meansOfDeath] = kills
+		}
+		if deaths > 0 {
+			if game.WeaponDeaths[player] == nil {
+				game.WeaponDeaths[player] = make(map[MeansOfDeath]int)
+			}
+			game.WeaponDeaths[player][meansOfDeath] = deaths
+		}
+	}
+	if err := statRows.Err(); err != nil {
+		return err
+	}
+
+	timelineRows, err := s.db.Query(`SELECT type, elapsed_seconds, player, old_name, killer, victim, weapon FROM timeline_events WHERE game_id = ? ORDER BY seq`, game.ID)
+	if err != nil {
+		return fmt.Errorf("error loading timeline events: %w", err)
+	}
+	defer timelineRows.Close()
+
+	game.Timeline = nil
+	for timelineRows.Next() {
+		var ev TimelineEvent
+		var eventType string
+		if err := timelineRows.Scan(&eventType, &ev.Elapsed, &ev.Player, &ev.OldName, &ev.Killer, &ev.Victim, &ev.Weapon); err != nil {
+			return fmt.Errorf("error scanning timeline event row: %w", err)
+		}
+		ev.Type = TimelineEventType(eventType)
+		game.Timeline = append(game.Timeline, ev)
+	}
+	return timelineRows.Err()
+}
@@ -0,0 +1,33 @@
+package main
+
+import "errors"
+
+// ErrGameNotFound is returned by Store.GetGame when no game with the
+// requested ID has been persisted.
+var ErrGameNotFound = errors.New("game not found")
+
+// Store persists parsed games so they survive process restarts and can be
+// served back out over the HTTP API. MemoryStore and SQLiteStore both
+// implement it; ingest mode upserts into whichever Store the "serve" or
+// "ingest" subcommand was configured with as ParseTail delivers new games.
+type Store interface {
+	// SaveGame upserts a completed game, replacing any previously stored
+	// game with the same ID.
+	SaveGame(game *Game) error
+
+	// GetGame returns a single game by ID, or ErrGameNotFound.
+	GetGame(id string) (*Game, error)
+
+	// ListGames returns every stored game.
+	ListGames() ([]*Game, error)
+
+	// PlayerMatches returns every stored game the named player appeared in.
+	PlayerMatches(name string) ([]*Game, error)
+
+	// Rankings returns player rankings aggregated across every stored game.
+	Rankings() ([]PlayerRanking, error)
+
+	// WeaponStats returns total kills per means-of-death across every
+	// stored game.
+	WeaponStats() (map[string]int, error)
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// BackpressureMode controls what ParseTail does when a consumer isn't
+// draining the event channel fast enough.
+type BackpressureMode int
+
+const (
+	// BackpressureBlock waits for the consumer to make room (default).
+	BackpressureBlock BackpressureMode = iota
+	// BackpressureDropOldest discards the oldest buffered event to make room
+	// for the new one, favoring freshness over completeness.
+	BackpressureDropOldest
+)
+
+// TailOptions configures ParseTailWithOptions.
+type TailOptions struct {
+	PollInterval time.Duration    // how often to check the file for new data
+	BufferSize   int              // size of the returned event channel's buffer
+	Backpressure BackpressureMode // behavior when the channel buffer is full
+}
+
+// DefaultTailOptions returns the options used by ParseTail.
+func DefaultTailOptions() TailOptions {
+	return TailOptions{
+		PollInterval: 500 * time.Millisecond,
+		BufferSize:   256,
+		Backpressure: BackpressureBlock,
+	}
+}
+
+// ParseTail watches a growing games.log, such as the one a running Quake 3
+// dedicated server appends to, and emits typed events on the returned
+// channel as new lines arrive. It resumes from the offset stored the last
+// time it tailed this file, and picks the new file up cleanly if the log is
+// rotated or truncated out from under it. The channel is closed when ctx is
+// canceled. It also returns the *Parser driving the channel, so a caller
+// can register additional Callbacks (OnKill, OnInitGame, ...) on the same
+// session instead of only consuming the channel.
+func ParseTail(ctx context.Context, filename string) (*Parser, <-chan Event, error) {
+	return ParseTailWithOptions(ctx, filename, DefaultTailOptions())
+}
+
+// ParseTailWithOptions is ParseTail with explicit polling and backpressure
+// behavior.
+func ParseTailWithOptions(ctx context.Context, filename string, opts TailOptions) (*Parser, <-chan Event, error) {
+	parser := NewParser()
+	file, pos, inode, err := openTailFile(filename, parser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make(chan Event, opts.BufferSize)
+	registerTailCallbacks(ctx, parser, events, opts.Backpressure)
+
+	go func() {
+		defer close(events)
+		followFile(ctx, filename, file, parser, opts.PollInterval, pos, inode)
+	}()
+
+	return parser, events, nil
+}
+
+// TailInto runs the same follow-and-resume loop as ParseTail, but against a
+// caller-supplied Parser instead of a channel. This is what ingest mode uses
+// to drive a Store directly off a Parser's callbacks (see ingest.go) without
+// paying for the Event envelope.
+func TailInto(ctx context.Context, filename string, parser *Parser, pollInterval time.Duration) error {
+	file, pos, inode, err := openTailFile(filename, parser)
+	if err != nil {
+		return err
+	}
+
+	followFile(ctx, filename, file, parser, pollInterval, pos, inode)
+	return nil
+}
+
+// tailState is what gets persisted to the sidecar file between tail runs.
+// Byte offset alone isn't enough to resume safely: a fresh Parser's
+// GameCounter restarts at 0, so without also restoring it, the next InitGame
+// after a restart is assigned the same "game_1"/"game_2"... ID a previous
+// run already saved, and upserting under that ID clobbers the old game's
+// stats. Persisting GameCounter (and the in-progress game's ID, if any)
+// keeps IDs unique across restarts.
+type tailState struct {
+	Offset        int64  `json:"offset"`
+	GameCounter   int    `json:"game_counter"`
+	CurrentGameID string `json:"current_game_id,omitempty"`
+}
+
+// openTailFile opens filename, seeks to the offset stored from a previous
+// tail of this file, and restores parser's GameCounter/CurrentGame so newly
+// assigned game IDs can't collide with ones from before a restart.
+func openTailFile(filename string, parser *Parser) (*os.File, int64, uint64, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("error opening file: %w", err)
+	}
+
+	var pos int64
+	if state, err := loadTailState(filename); err == nil {
+		if _, err := file.Seek(state.Offset, 0); err == nil {
+			pos = state.Offset
+		}
+		parser.GameCounter = state.GameCounter
+		if state.CurrentGameID != "" {
+			parser.resumeGame(state.CurrentGameID)
+		}
+	}
+
+	fi, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, 0, fmt.Errorf("error stating file: %w", err)
+	}
+	inode, _ := fileInode(fi)
+
+	return file, pos, inode, nil
+}
+
+// followFile is the background polling loop shared by ParseTail and
+// TailInto. It feeds new lines into parser, persists tail state after each
+// pass, and transparently reopens filename on rotation or truncation.
+func followFile(ctx context.Context, filename string, file *os.File, parser *Parser, pollInterval time.Duration, pos int64, inode uint64) {
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			line, err := reader.ReadString('\n')
+			if len(line) > 0 {
+				pos += int64(len(line))
+				trimmed := strings.TrimRight(line, "\r\n")
+				if strings.TrimSpace(trimmed) != "" && !strings.Contains(trimmed, "----") {
+					if entry, perr := parser.parseLine(trimmed); perr == nil {
+						parser.processEntry(entry)
+					}
+				}
+			}
+			if err != nil {
+				break
+			}
+		}
+		saveTailState(filename, pos, parser)
+
+		if newFile, newReader, newPos, newInode, rotated := checkRotation(filename, file, pos, inode); rotated {
+			file.Close()
+			file, reader, pos, inode = newFile, newReader, newPos, newInode
+			saveTailState(filename, pos, parser)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkRotation detects an inode change (rotation) or a shrunk file size
+// (truncation) and, if found, reopens the file from the start.
+func checkRotation(filename string, file *os.File, pos int64, inode uint64) (*os.File, *bufio.Reader, int64, uint64, bool) {
+	fi, err := os.Stat(filename)
+	if err != nil {
+		return file, nil, pos, inode, false
+	}
+
+	newInode, ok := fileInode(fi)
+	rotated := ok && newInode != inode
+	truncated := fi.Size() < pos
+
+	if !rotated && !truncated {
+		return file, nil, pos, inode, false
+	}
+
+	newFile, err := os.Open(filename)
+	if err != nil {
+		return file, nil, pos, inode, false
+	}
+
+	return newFile, bufio.NewReader(newFile), 0, newInode, true
+}
+
+// registerTailCallbacks wires a fresh Parser's callbacks to push typed
+// events onto the tail channel, honoring the configured backpressure mode.
+func registerTailCallbacks(ctx context.Context, parser *Parser, events chan Event, mode BackpressureMode) {
+	parser.Callbacks.OnInitGame(func(gameID string) {
+		pushEvent(ctx, events, mode, Event{Type: EventTypeInitGame, InitGame: &InitGameEvent{GameID: gameID}})
+	})
+
+	parser.Callbacks.OnKill(func(ev KillEvent) {
+		pushEvent(ctx, events, mode, Event{Type: EventTypeKill, Kill: &ev})
+	})
+
+	parser.Callbacks.OnClientUserinfoChanged(func(ev ClientInfoEvent) {
+		pushEvent(ctx, events, mode, Event{Type: EventTypePlayerJoin, PlayerJoin: &PlayerJoinEvent{
+			GameID:   ev.GameID,
+			ClientID: ev.ClientID,
+			Name:     ev.Name,
+		}})
+	})
+
+	parser.Callbacks.OnShutdownGame(func(gameID string, summary *Game) {
+		pushEvent(ctx, events, mode, Event{Type: EventTypeExit, Exit: &ExitEvent{GameID: gameID}})
+	})
+}
+
+// pushEvent delivers ev to events according to mode, never blocking past
+// ctx's cancellation.
+func pushEvent(ctx context.Context, events chan Event, mode BackpressureMode, ev Event) {
+	if mode == BackpressureDropOldest {
+		select {
+		case events <- ev:
+		default:
+			select {
+			case <-events:
+			default:
+			}
+			select {
+			case events <- ev:
+			default:
+			}
+		}
+		return
+	}
+
+	select {
+	case events <- ev:
+	case <-ctx.Done():
+	}
+}
+
+// tailStateFilePath returns the sidecar file ParseTail uses to remember how
+// far into filename it had read, and what game ID sequence it had reached.
+func tailStateFilePath(filename string) string {
+	return filename + ".offset"
+}
+
+// loadTailState reads the last persisted tail state for filename, if any.
+func loadTailState(filename string) (tailState, error) {
+	data, err := os.ReadFile(tailStateFilePath(filename))
+	if err != nil {
+		return tailState{}, err
+	}
+
+	var state tailState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return tailState{}, fmt.Errorf("error decoding tail state: %w", err)
+	}
+	return state, nil
+}
+
+// saveTailState persists filename's read offset and the parser's game ID
+// sequence so a future tail of this file can resume without reusing IDs a
+// prior run already saved.
+func saveTailState(filename string, offset int64, parser *Parser) {
+	state := tailState{Offset: offset, GameCounter: parser.GameCounter}
+	if parser.CurrentGame != nil {
+		state.CurrentGameID = parser.CurrentGame.ID
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(tailStateFilePath(filename), data, 0644)
+}
+
+// fileInode extracts the inode number from a FileInfo on platforms that
+// expose it via syscall.Stat_t (Linux and other POSIX systems).
+func fileInode(fi os.FileInfo) (uint64, bool) {
+	stat, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, false
+	}
+	return stat.Ino, true
+}
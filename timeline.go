@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// sortedGameIDs returns games's keys in a stable, human-friendly order for
+// output formats that print one game at a time.
+func sortedGameIDs(games map[string]*Game) []string {
+	ids := make([]string, 0, len(games))
+	for id := range games {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// TimelineEventType identifies what kind of notable moment a TimelineEvent
+// represents.
+type TimelineEventType string
+
+// Timeline event types, in the order they can occur within a game.
+const (
+	TimelineJoin       TimelineEventType = "join"
+	TimelineNameChange TimelineEventType = "name_change"
+	TimelineKill       TimelineEventType = "kill"
+	TimelineExit       TimelineEventType = "exit"
+	TimelineShutdown   TimelineEventType = "shutdown"
+)
+
+// TimelineEvent is one entry in a Game's replay-style timeline, ordered by
+// Elapsed (seconds since InitGame).
+type TimelineEvent struct {
+	Type    TimelineEventType `json:"type"`
+	Elapsed int               `json:"elapsed_seconds"`
+	Player  string            `json:"player,omitempty"`
+	OldName string            `json:"old_name,omitempty"`
+	Killer  string            `json:"killer,omitempty"`
+	Victim  string            `json:"victim,omitempty"`
+	Weapon  string            `json:"weapon,omitempty"`
+}
+
+// parseTimestampSeconds converts a log timestamp of the form "mm:ss" into
+// total seconds.
+func parseTimestampSeconds(ts string) (int, error) {
+	minutes, seconds, ok := strings.Cut(ts, ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid timestamp: %s", ts)
+	}
+
+	m, err := strconv.Atoi(minutes)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp minutes: %s", ts)
+	}
+	s, err := strconv.Atoi(seconds)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp seconds: %s", ts)
+	}
+
+	return m*60 + s, nil
+}
+
+// recordTimelineEvent appends ev to CurrentGame's timeline, filling in its
+// Elapsed time from entry's timestamp relative to the game's InitGame line.
+func (p *Parser) recordTimelineEvent(entry *LogEntry, ev TimelineEvent) {
+	if p.CurrentGame == nil {
+		return
+	}
+
+	if seconds, err := parseTimestampSeconds(entry.Timestamp); err == nil {
+		ev.Elapsed = seconds - p.gameStartSeconds
+	}
+
+	p.CurrentGame.Timeline = append(p.CurrentGame.Timeline, ev)
+}
+
+// recordTimelineRegistration turns a PlayerManager registration outcome
+// ("join", "rename", or "unchanged") into the matching timeline event.
+// previousName is the canonical name being replaced, and is only set when
+// registration is "rename".
+func (p *Parser) recordTimelineRegistration(entry *LogEntry, registration, canonicalName, previousName string) {
+	switch registration {
+	case "join":
+		p.recordTimelineEvent(entry, TimelineEvent{Type: TimelineJoin, Player: canonicalName})
+	case "rename":
+		p.recordTimelineEvent(entry, TimelineEvent{Type: TimelineNameChange, Player: canonicalName, OldName: previousName})
+	}
+}
+
+// Replay renders a human-readable, minute-by-minute recap of the game to w,
+// e.g. "02:17 Isgalamido killed Dono da Bola with RAILGUN".
+func (g *Game) Replay(w io.Writer) error {
+	for _, ev := range g.Timeline {
+		minutes := ev.Elapsed / 60
+		seconds := ev.Elapsed % 60
+		stamp := fmt.Sprintf("%02d:%02d", minutes, seconds)
+
+		var line string
+		switch ev.Type {
+		case TimelineJoin:
+			line = fmt.Sprintf("%s %s joined the game", stamp, ev.Player)
+		case TimelineNameChange:
+			if ev.OldName != "" {
+				line = fmt.Sprintf("%s %s changed their name from %s", stamp, ev.Player, ev.OldName)
+			} else {
+				line = fmt.Sprintf("%s %s changed their name", stamp, ev.Player)
+			}
+		case TimelineKill:
+			if ev.Killer == "<world>" || ev.Killer == "" {
+				line = fmt.Sprintf("%s %s died (%s)", stamp, ev.Victim, ev.Weapon)
+			} else {
+				line = fmt.Sprintf("%s %s killed %s with %s", stamp, ev.Killer, ev.Victim, ev.Weapon)
+			}
+		case TimelineExit:
+			line = fmt.Sprintf("%s match ended", stamp)
+		case TimelineShutdown:
+			line = fmt.Sprintf("%s server shut down the game", stamp)
+		default:
+			continue
+		}
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package main
+
+// MeansOfDeath identifies how a kill happened, mirroring Quake 3 Arena's
+// MOD_* constants from bg_public.h. The parser decodes the weapon token on
+// each Kill line into one of these instead of carrying the raw log string
+// around, so downstream reports get a stable, typo-proof key.
+type MeansOfDeath int
+
+// Means of death, in the order Quake 3 Arena defines them.
+const (
+	MODUnknown MeansOfDeath = iota
+	MODShotgun
+	MODGauntlet
+	MODMachinegun
+	MODGrenade
+	MODGrenadeSplash
+	MODRocket
+	MODRocketSplash
+	MODPlasma
+	MODPlasmaSplash
+	MODRailgun
+	MODLightning
+	MODBFG
+	MODBFGSplash
+	MODWater
+	MODSlime
+	MODLava
+	MODCrush
+	MODTelefrag
+	MODFalling
+	MODSuicide
+	MODTargetLaser
+	MODTriggerHurt
+	MODNail
+	MODChaingun
+	MODProximityMine
+	MODKamikaze
+	MODJuiced
+	MODGrapple
+)
+
+// modNames maps each MeansOfDeath to the exact MOD_* token Quake 3 writes
+// to games.log.
+var modNames = map[MeansOfDeath]string{
+	MODUnknown:       "MOD_UNKNOWN",
+	MODShotgun:       "MOD_SHOTGUN",
+	MODGauntlet:      "MOD_GAUNTLET",
+	MODMachinegun:    "MOD_MACHINEGUN",
+	MODGrenade:       "MOD_GRENADE",
+	MODGrenadeSplash: "MOD_GRENADE_SPLASH",
+	MODRocket:        "MOD_ROCKET",
+	MODRocketSplash:  "MOD_ROCKET_SPLASH",
+	MODPlasma:        "MOD_PLASMA",
+	MODPlasmaSplash:  "MOD_PLASMA_SPLASH",
+	MODRailgun:       "MOD_RAILGUN",
+	MODLightning:     "MOD_LIGHTNING",
+	MODBFG:           "MOD_BFG",
+	MODBFGSplash:     "MOD_BFG_SPLASH",
+	MODWater:         "MOD_WATER",
+	MODSlime:         "MOD_SLIME",
+	MODLava:          "MOD_LAVA",
+	MODCrush:         "MOD_CRUSH",
+	MODTelefrag:      "MOD_TELEFRAG",
+	MODFalling:       "MOD_FALLING",
+	MODSuicide:       "MOD_SUICIDE",
+	MODTargetLaser:   "MOD_TARGET_LASER",
+	MODTriggerHurt:   "MOD_TRIGGER_HURT",
+	MODNail:          "MOD_NAIL",
+	MODChaingun:      "MOD_CHAINGUN",
+	MODProximityMine: "MOD_PROXIMITY_MINE",
+	MODKamikaze:      "MOD_KAMIKAZE",
+	MODJuiced:        "MOD_JUICED",
+	MODGrapple:       "MOD_GRAPPLE",
+}
+
+// modByName is the reverse of modNames, built once for ParseMeansOfDeath.
+var modByName = func() map[string]MeansOfDeath {
+	byName := make(map[string]MeansOfDeath, len(modNames))
+	for mod, name := range modNames {
+		byName[name] = mod
+	}
+	return byName
+}()
+
+// String returns the MOD_* token for m, e.g. "MOD_ROCKET".
+func (m MeansOfDeath) String() string {
+	if name, ok := modNames[m]; ok {
+		return name
+	}
+	return modNames[MODUnknown]
+}
+
+// MarshalJSON encodes m as its MOD_* token so JSON output keeps the format
+// consumers of the "kills_by_means" spec already expect.
+func (m MeansOfDeath) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + m.String() + `"`), nil
+}
+
+// MarshalText encodes m as its MOD_* token, used by encoding/json when m is
+// a map key (e.g. the per-game report from GetKillsByMeansReport).
+func (m MeansOfDeath) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// ParseMeansOfDeath decodes a weapon token parsed off a Kill line (e.g.
+// "MOD_ROCKET") into a MeansOfDeath, falling back to MODUnknown for tokens
+// this parser doesn't recognize.
+func ParseMeansOfDeath(token string) MeansOfDeath {
+	if mod, ok := modByName[token]; ok {
+		return mod
+	}
+	return MODUnknown
+}
+
+// environmentalMOD holds the means of death that are the world's doing, not
+// a player's, regardless of what client ID the log line happens to list as
+// the killer.
+var environmentalMOD = map[MeansOfDeath]bool{
+	MODTriggerHurt: true,
+	MODFalling:     true,
+	MODLava:        true,
+	MODSlime:       true,
+	MODWater:       true,
+}
+
+// IsEnvironmental reports whether m is a world/environment kill (falling,
+// lava, slime, drowning, or a hurt trigger) rather than a player's doing.
+func IsEnvironmental(m MeansOfDeath) bool {
+	return environmentalMOD[m]
+}
+
+// WeaponStat is one player's kill/death tally for a single means of death,
+// returned by Parser.GetPlayerWeaponStats.
+type WeaponStat struct {
+	Weapon MeansOfDeath `json:"weapon"`
+	Kills  int          `json:"kills"`
+	Deaths int          `json:"deaths"`
+}
+
+// GetKillsByMeansReport returns, for every parsed game, how many kills
+// happened by each means of death — the "kills_by_means" breakdown from the
+// well-known Cloudwalk Quake 3 log parsing challenge.
+func (p *Parser) GetKillsByMeansReport() map[string]map[MeansOfDeath]int {
+	report := make(map[string]map[MeansOfDeath]int, len(p.Games))
+	for gameID, game := range p.Games {
+		report[gameID] = game.KillsByMODCount
+	}
+	return report
+}
+
+// GetPlayerWeaponStats returns player's kills and deaths broken down by
+// means of death, aggregated across every parsed game.
+func (p *Parser) GetPlayerWeaponStats(player string) []WeaponStat {
+	totals := make(map[MeansOfDeath]*WeaponStat)
+
+	for _, game := range p.Games {
+		for mod, kills := range game.WeaponKills[player] {
+			stat := totals[mod]
+			if stat == nil {
+				stat = &WeaponStat{Weapon: mod}
+				totals[mod] = stat
+			}
+			stat.Kills += kills
+		}
+		for mod, deaths := range game.WeaponDeaths[player] {
+			stat := totals[mod]
+			if stat == nil {
+				stat = &WeaponStat{Weapon: mod}
+				totals[mod] = stat
+			}
+			stat.Deaths += deaths
+		}
+	}
+
+	stats := make([]WeaponStat, 0, len(totals))
+	for _, stat := range totals {
+		stats = append(stats, *stat)
+	}
+	return stats
+}